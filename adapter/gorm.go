@@ -0,0 +1,37 @@
+package adapter
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/xafelium/pagination"
+)
+
+// ApplyGorm scopes db with p's limit/offset and, when p.Sort is set, an
+// ORDER BY clause produced by parseSort (which is expected to allow-list the
+// underlying column).
+func ApplyGorm(db *gorm.DB, p pagination.Pagination, parseSort pagination.SortParserFunc) (*gorm.DB, error) {
+	q := db.Limit(p.Limit).Offset(p.Offset)
+	if p.Sort != "" {
+		if parseSort == nil {
+			return nil, errors.New("sort requested but no SortParserFunc was supplied")
+		}
+		orderBy, err := parseSort(p.Sort)
+		if err != nil {
+			return nil, err
+		}
+		q = q.Order(orderBy)
+	}
+	return q, nil
+}
+
+// CountGorm runs db's COUNT query, ignoring any limit/offset/order already
+// applied, and returns the total row count for use with pagination.BuildPageMeta.
+func CountGorm(db *gorm.DB) (int, error) {
+	var count int64
+	if err := db.Session(&gorm.Session{}).Limit(-1).Offset(-1).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
@@ -0,0 +1,90 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/xafelium/pagination"
+)
+
+type gormTestModel struct {
+	ID   uint
+	Name string
+}
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %+v", err)
+	}
+	if err := db.AutoMigrate(&gormTestModel{}); err != nil {
+		t.Fatalf("automigrate: %+v", err)
+	}
+	return db
+}
+
+func TestApplyGorm(t *testing.T) {
+	db := openTestDB(t)
+	p := pagination.Pagination{Limit: 20, Offset: 40, Sort: "name"}
+	parseSort := func(sort string) (string, error) {
+		if sort != "name" {
+			return "", fmt.Errorf("unknown sort column %q", sort)
+		}
+		return "name ASC", nil
+	}
+
+	scoped, err := ApplyGorm(db, p, parseSort)
+	if err != nil {
+		t.Fatalf("ApplyGorm() error: %+v", err)
+	}
+
+	sql := scoped.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&[]gormTestModel{})
+	})
+	if !strings.Contains(sql, "LIMIT 20") || !strings.Contains(sql, "OFFSET 40") {
+		t.Fatalf("ApplyGorm() generated SQL missing LIMIT/OFFSET: %s", sql)
+	}
+	if !strings.Contains(sql, "ORDER BY name ASC") {
+		t.Fatalf("ApplyGorm() generated SQL missing ORDER BY: %s", sql)
+	}
+}
+
+func TestApplyGormUnknownSortColumn(t *testing.T) {
+	db := openTestDB(t)
+	p := pagination.Pagination{Limit: 20, Sort: "secret"}
+	parseSort := func(sort string) (string, error) {
+		return "", fmt.Errorf("column not allowed")
+	}
+
+	if _, err := ApplyGorm(db, p, parseSort); err == nil {
+		t.Fatal("expected an error for a disallowed sort column")
+	}
+}
+
+func TestApplyGormMissingSortParser(t *testing.T) {
+	db := openTestDB(t)
+	p := pagination.Pagination{Limit: 20, Sort: "name"}
+
+	if _, err := ApplyGorm(db, p, nil); err == nil {
+		t.Fatal("expected an error when Sort is set but no SortParserFunc is supplied")
+	}
+}
+
+func TestCountGorm(t *testing.T) {
+	db := openTestDB(t)
+	db.Create(&gormTestModel{Name: "a"})
+	db.Create(&gormTestModel{Name: "b"})
+
+	count, err := CountGorm(db.Model(&gormTestModel{}))
+	if err != nil {
+		t.Fatalf("CountGorm() error: %+v", err)
+	}
+	if count != 2 {
+		t.Fatalf("CountGorm() = %d, want 2", count)
+	}
+}
@@ -0,0 +1,35 @@
+package adapter
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/xafelium/pagination"
+)
+
+// MongoSortParserFunc parses a sort string into a Mongo sort document. It
+// mirrors pagination.SortParserFunc for backends that need a bson.D rather
+// than a SQL ORDER BY fragment, and is expected to allow-list the underlying
+// field names.
+type MongoSortParserFunc func(sort string) (bson.D, error)
+
+// ApplyMongo builds the *options.FindOptions for p: SetLimit/SetSkip and,
+// when p.Sort is set, SetSort with the document produced by parseSort. The
+// total count needed for pagination.BuildPageMeta comes from the caller's
+// own CountDocuments call against the same filter, unaffected by these options.
+func ApplyMongo(p pagination.Pagination, parseSort MongoSortParserFunc) (*options.FindOptions, error) {
+	opts := options.Find().SetLimit(int64(p.Limit)).SetSkip(int64(p.Offset))
+	if p.Sort != "" {
+		if parseSort == nil {
+			return nil, errors.New("sort requested but no MongoSortParserFunc was supplied")
+		}
+		sort, err := parseSort(p.Sort)
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetSort(sort)
+	}
+	return opts, nil
+}
@@ -0,0 +1,57 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/xafelium/pagination"
+)
+
+func TestApplyMongo(t *testing.T) {
+	p := pagination.Pagination{Limit: 20, Offset: 40, Sort: "name"}
+	parseSort := func(sort string) (bson.D, error) {
+		if sort != "name" {
+			return nil, fmt.Errorf("unknown sort field %q", sort)
+		}
+		return bson.D{{Key: "name", Value: 1}}, nil
+	}
+
+	opts, err := ApplyMongo(p, parseSort)
+	if err != nil {
+		t.Fatalf("ApplyMongo() error: %+v", err)
+	}
+
+	if opts.Limit == nil || *opts.Limit != 20 {
+		t.Fatalf("ApplyMongo() Limit = %v, want 20", opts.Limit)
+	}
+	if opts.Skip == nil || *opts.Skip != 40 {
+		t.Fatalf("ApplyMongo() Skip = %v, want 40", opts.Skip)
+	}
+	expectedSort := bson.D{{Key: "name", Value: 1}}
+	if !reflect.DeepEqual(expectedSort, opts.Sort) {
+		t.Fatalf("ApplyMongo() Sort = %+v, want %+v", opts.Sort, expectedSort)
+	}
+}
+
+func TestApplyMongoUnknownSortField(t *testing.T) {
+	p := pagination.Pagination{Limit: 20, Sort: "secret"}
+	parseSort := func(sort string) (bson.D, error) {
+		return nil, errors.New("field not allowed")
+	}
+
+	if _, err := ApplyMongo(p, parseSort); err == nil {
+		t.Fatal("expected an error for a disallowed sort field")
+	}
+}
+
+func TestApplyMongoMissingSortParser(t *testing.T) {
+	p := pagination.Pagination{Limit: 20, Sort: "name"}
+
+	if _, err := ApplyMongo(p, nil); err == nil {
+		t.Fatal("expected an error when Sort is set but no MongoSortParserFunc is supplied")
+	}
+}
@@ -0,0 +1,41 @@
+// Package adapter wires pagination.Pagination into common Go data-layer
+// builders (database/sql-style query builders, GORM, Mongo).
+package adapter
+
+import (
+	"errors"
+
+	"github.com/xafelium/pagination"
+)
+
+// Query is the minimal SQL builder surface the SQL adapter needs: a
+// database/sql or sqlx-style builder that supports chaining LIMIT/OFFSET/
+// ORDER BY, such as Masterminds/squirrel's SelectBuilder.
+type Query interface {
+	Limit(limit uint64) Query
+	Offset(offset uint64) Query
+	OrderBy(orderBys ...string) Query
+}
+
+// Apply appends LIMIT/OFFSET to q and, when p.Sort is set, an ORDER BY
+// clause produced by parseSort (which is expected to allow-list the
+// underlying column). It also returns q unmodified as the count query,
+// since a COUNT query must not carry a LIMIT/OFFSET/ORDER BY of its own;
+// callers run it to populate pagination.BuildPageMeta's total.
+func Apply(q Query, p pagination.Pagination, parseSort pagination.SortParserFunc) (dataQuery Query, countQuery Query, err error) {
+	countQuery = q
+
+	dataQuery = q.Limit(uint64(p.Limit)).Offset(uint64(p.Offset))
+	if p.Sort != "" {
+		if parseSort == nil {
+			return nil, nil, errors.New("sort requested but no SortParserFunc was supplied")
+		}
+		orderBy, err := parseSort(p.Sort)
+		if err != nil {
+			return nil, nil, err
+		}
+		dataQuery = dataQuery.OrderBy(orderBy)
+	}
+
+	return dataQuery, countQuery, nil
+}
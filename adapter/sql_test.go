@@ -0,0 +1,77 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/xafelium/pagination"
+)
+
+// fakeQuery is a minimal Query implementation used to assert Apply's calls
+// without depending on a real SQL builder.
+type fakeQuery struct {
+	limit, offset uint64
+	orderBys      []string
+}
+
+func (q fakeQuery) Limit(limit uint64) Query {
+	q.limit = limit
+	return q
+}
+
+func (q fakeQuery) Offset(offset uint64) Query {
+	q.offset = offset
+	return q
+}
+
+func (q fakeQuery) OrderBy(orderBys ...string) Query {
+	q.orderBys = orderBys
+	return q
+}
+
+func TestApply(t *testing.T) {
+	p := pagination.Pagination{Limit: 20, Offset: 40, Sort: "name"}
+	parseSort := func(sort string) (string, error) {
+		if sort != "name" {
+			return "", fmt.Errorf("unknown sort column %q", sort)
+		}
+		return "name ASC", nil
+	}
+
+	dataQuery, countQuery, err := Apply(fakeQuery{}, p, parseSort)
+	if err != nil {
+		t.Fatalf("Apply() error: %+v", err)
+	}
+
+	got := dataQuery.(fakeQuery)
+	if got.limit != 20 || got.offset != 40 {
+		t.Fatalf("Apply() limit/offset = %d/%d, want 20/40", got.limit, got.offset)
+	}
+	if len(got.orderBys) != 1 || got.orderBys[0] != "name ASC" {
+		t.Fatalf("Apply() orderBys = %+v, want [\"name ASC\"]", got.orderBys)
+	}
+
+	if _, ok := countQuery.(fakeQuery); !ok {
+		t.Fatal("Apply() countQuery should be the original, unmodified query")
+	}
+}
+
+func TestApplyUnknownSortColumn(t *testing.T) {
+	p := pagination.Pagination{Limit: 20, Sort: "secret"}
+	parseSort := func(sort string) (string, error) {
+		return "", errors.New("column not allowed")
+	}
+
+	if _, _, err := Apply(fakeQuery{}, p, parseSort); err == nil {
+		t.Fatal("expected an error for a disallowed sort column")
+	}
+}
+
+func TestApplyMissingSortParser(t *testing.T) {
+	p := pagination.Pagination{Limit: 20, Sort: "name"}
+
+	if _, _, err := Apply(fakeQuery{}, p, nil); err == nil {
+		t.Fatal("expected an error when Sort is set but no SortParserFunc is supplied")
+	}
+}
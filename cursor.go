@@ -0,0 +1,180 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// CursorPagination contains cursor-based (keyset) pagination information.
+type CursorPagination struct {
+	Limit  int
+	After  string
+	Before string
+	Sort   string
+}
+
+// Validate validates the CursorPagination object.
+func (p *CursorPagination) Validate() error {
+	if p.Limit < 1 {
+		return errors.New("limit must be positive")
+	}
+	if p.After != "" && p.Before != "" {
+		return errors.New("after and before cannot both be set")
+	}
+	return nil
+}
+
+func (p *CursorPagination) String() string {
+	return fmt.Sprintf("Limit: %d, After: %s, Before: %s, Sort: %s", p.Limit, p.After, p.Before, p.Sort)
+}
+
+// EncodeCursor encodes the provided sort key/tiebreaker values into an opaque
+// base64url-encoded cursor token.
+func EncodeCursor(values map[string]any) string {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor token produced by EncodeCursor back into its
+// sort key/tiebreaker values.
+func DecodeCursor(token string) (map[string]any, error) {
+	if token == "" {
+		return nil, errors.New("cursor token is empty")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", err)
+	}
+	values := map[string]any{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", err)
+	}
+	return values, nil
+}
+
+// CursorPageLinks contains methods for cursor/keyset pagination links.
+type CursorPageLinks interface {
+	FirstPageLink() (string, error)
+	HasNextPage() bool
+	NextPageLink() (string, error)
+	HasPrevPage() bool
+	PrevPageLink() (string, error)
+	ToHeader() (string, error)
+}
+
+// NewCursorPageLinks creates a new CursorPageLinks object. nextCursor and
+// prevCursor should be the opaque cursor tokens stamped from the last-seen
+// rows of the current page, or empty strings when there is no such page.
+func NewCursorPageLinks(baseUrl, rawQuery string, limit int, nextCursor, prevCursor string) (CursorPageLinks, error) {
+	if limit <= 0 {
+		return nil, errors.New("limit must be positive")
+	}
+	return cursorPageLinks{
+		baseUrl:    baseUrl,
+		rawQuery:   rawQuery,
+		limit:      limit,
+		nextCursor: nextCursor,
+		prevCursor: prevCursor,
+	}, nil
+}
+
+// cursorPageLinks is a CursorPageLinks implementation.
+type cursorPageLinks struct {
+	baseUrl    string
+	rawQuery   string
+	limit      int
+	nextCursor string
+	prevCursor string
+}
+
+// FirstPageLink returns the link to the first page, i.e. no cursor set.
+func (p cursorPageLinks) FirstPageLink() (string, error) {
+	return buildCursorLink(p.baseUrl, p.rawQuery, p.limit, "", "")
+}
+
+// HasNextPage returns true when there is a next page.
+func (p cursorPageLinks) HasNextPage() bool {
+	return p.nextCursor != ""
+}
+
+// NextPageLink returns the link to the next page.
+func (p cursorPageLinks) NextPageLink() (string, error) {
+	if !p.HasNextPage() {
+		return "", fmt.Errorf("CursorPagination has no next page")
+	}
+	return buildCursorLink(p.baseUrl, p.rawQuery, p.limit, p.nextCursor, "")
+}
+
+// HasPrevPage returns true when there is a previous page.
+func (p cursorPageLinks) HasPrevPage() bool {
+	return p.prevCursor != ""
+}
+
+// PrevPageLink returns the link to the previous page.
+func (p cursorPageLinks) PrevPageLink() (string, error) {
+	if !p.HasPrevPage() {
+		return "", fmt.Errorf("CursorPagination has no previous page")
+	}
+	return buildCursorLink(p.baseUrl, p.rawQuery, p.limit, "", p.prevCursor)
+}
+
+// ToHeader returns a header field representation of the links. Unlike the
+// offset-based PageLinks, "last" is never known ahead of time for a cursor
+// and is therefore omitted.
+func (p cursorPageLinks) ToHeader() (string, error) {
+	var link string
+	var err error
+	header := ""
+
+	link, err = p.FirstPageLink()
+	if err != nil {
+		return "", err
+	}
+	header += fmt.Sprintf(`<%s>; rel="first"`, link)
+
+	if p.HasPrevPage() {
+		link, err = p.PrevPageLink()
+		if err != nil {
+			return "", err
+		}
+		header += fmt.Sprintf(`, <%s>; rel="prev"`, link)
+	}
+
+	if p.HasNextPage() {
+		link, err = p.NextPageLink()
+		if err != nil {
+			return "", err
+		}
+		header += fmt.Sprintf(`, <%s>; rel="next"`, link)
+	}
+
+	return header, nil
+}
+
+// buildCursorLink builds the link for the provided cursor values. Setting
+// after clears before and vice versa, since only one may be active at a time.
+func buildCursorLink(baseUrl string, rawQuery string, limit int, after, before string) (string, error) {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", err
+	}
+	values.Set("limit", fmt.Sprintf("%d", limit))
+	values.Del("after")
+	values.Del("before")
+	if after != "" {
+		values.Set("after", after)
+	}
+	if before != "" {
+		values.Set("before", before)
+	}
+	if baseUrl[len(baseUrl)-1:] != "?" {
+		baseUrl += "?"
+	}
+	return baseUrl + values.Encode(), nil
+}
@@ -0,0 +1,121 @@
+package pagination
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecodeCursor(t *testing.T) {
+	values := map[string]any{"created_at": "2023-01-02T15:04:05Z", "id": float64(42)}
+
+	token := EncodeCursor(values)
+	if token == "" {
+		t.Fatal("EncodeCursor returned an empty token")
+	}
+
+	decoded, err := DecodeCursor(token)
+	if err != nil {
+		t.Fatalf("DecodeCursor() error: %+v", err)
+	}
+	if !reflect.DeepEqual(values, decoded) {
+		t.Fatalf("DecodeCursor()\n\texpected: %+v\n\tgot: %+v", values, decoded)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	if _, err := DecodeCursor(""); err == nil {
+		t.Fatal("expected an error for an empty cursor token")
+	}
+	if _, err := DecodeCursor("not-valid-base64!!"); err == nil {
+		t.Fatal("expected an error for an invalid cursor token")
+	}
+}
+
+func TestCursorPageLinks(t *testing.T) {
+	baseUrl := "https://api.example.com/api/v1/cars"
+	rawQuery := "limit=15"
+
+	p, err := NewCursorPageLinks(baseUrl, rawQuery, 15, "next-token", "prev-token")
+	if err != nil {
+		t.Fatalf("NewCursorPageLinks() error: %+v", err)
+	}
+
+	firstPageLink, err := p.FirstPageLink()
+	if err != nil {
+		t.Fatalf("FirstPageLink() error: %+v", err)
+	}
+	expectedFirstPageLink := "https://api.example.com/api/v1/cars?limit=15"
+	if firstPageLink != expectedFirstPageLink {
+		t.Fatalf("FirstPageLink()\n\texpected: %s\n\tgot: %s", expectedFirstPageLink, firstPageLink)
+	}
+
+	if !p.HasNextPage() {
+		t.Fatal("expected HasNextPage() to be true")
+	}
+	nextPageLink, err := p.NextPageLink()
+	if err != nil {
+		t.Fatalf("NextPageLink() error: %+v", err)
+	}
+	expectedNextPageLink := "https://api.example.com/api/v1/cars?after=next-token&limit=15"
+	if nextPageLink != expectedNextPageLink {
+		t.Fatalf("NextPageLink()\n\texpected: %s\n\tgot: %s", expectedNextPageLink, nextPageLink)
+	}
+
+	if !p.HasPrevPage() {
+		t.Fatal("expected HasPrevPage() to be true")
+	}
+	prevPageLink, err := p.PrevPageLink()
+	if err != nil {
+		t.Fatalf("PrevPageLink() error: %+v", err)
+	}
+	expectedPrevPageLink := "https://api.example.com/api/v1/cars?before=prev-token&limit=15"
+	if prevPageLink != expectedPrevPageLink {
+		t.Fatalf("PrevPageLink()\n\texpected: %s\n\tgot: %s", expectedPrevPageLink, prevPageLink)
+	}
+}
+
+func TestCursorPageLinksNoSurroundingPages(t *testing.T) {
+	p, err := NewCursorPageLinks("https://api.example.com/cars", "limit=10", 10, "", "")
+	if err != nil {
+		t.Fatalf("NewCursorPageLinks() error: %+v", err)
+	}
+
+	if p.HasNextPage() {
+		t.Fatal("expected HasNextPage() to be false")
+	}
+	if p.HasPrevPage() {
+		t.Fatal("expected HasPrevPage() to be false")
+	}
+
+	header, err := p.ToHeader()
+	if err != nil {
+		t.Fatalf("ToHeader() error: %+v", err)
+	}
+	expectedHeader := `<https://api.example.com/cars?limit=10>; rel="first"`
+	if header != expectedHeader {
+		t.Fatalf("ToHeader()\n\texpected: %s\n\tgot: %s", expectedHeader, header)
+	}
+}
+
+func TestNewCursorPageLinksInvalidLimit(t *testing.T) {
+	if _, err := NewCursorPageLinks("https://api.example.com/cars", "", 0, "", ""); err == nil {
+		t.Fatal("expected an error for a non-positive limit")
+	}
+}
+
+func TestCursorPaginationValidate(t *testing.T) {
+	p := CursorPagination{Limit: 10, After: "a", Before: "b"}
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected an error when both After and Before are set")
+	}
+
+	p = CursorPagination{Limit: 0}
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected an error for a non-positive limit")
+	}
+
+	p = CursorPagination{Limit: 10, After: "a"}
+	if err := p.Validate(); err != nil {
+		t.Fatalf("Validate() error: %+v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package pagination
+
+// Group is a named bucket of pre-grouped items, e.g. posts grouped by year.
+type Group struct {
+	Key   string
+	Items []any
+}
+
+// PaginateGroups slices across groups while preserving group boundaries, so
+// that callers paginating results already bucketed by some key (date,
+// category, ...) get a single page containing whole or partial groups. The
+// returned page metadata accounts for the total item count across all
+// groups, not the number of groups.
+func PaginateGroups(groups []Group, limit, offset int) ([]Group, map[string]PageMeta) {
+	if limit <= 0 {
+		return []Group{}, map[string]PageMeta{
+			"first": {Limit: limit, Offset: 0},
+			"last":  {Limit: limit, Offset: 0},
+		}
+	}
+
+	total := 0
+	for _, g := range groups {
+		total += len(g.Items)
+	}
+
+	page := make([]Group, 0, len(groups))
+	skip := offset
+	remaining := limit
+	for _, g := range groups {
+		if remaining <= 0 {
+			break
+		}
+
+		items := g.Items
+		if skip > 0 {
+			if skip >= len(items) {
+				skip -= len(items)
+				continue
+			}
+			items = items[skip:]
+			skip = 0
+		}
+
+		if len(items) > remaining {
+			items = items[:remaining]
+		}
+		page = append(page, Group{Key: g.Key, Items: items})
+		remaining -= len(items)
+	}
+
+	return page, BuildPageMeta(total, limit, offset)
+}
+
+// PageCountGrouped calculates the total number of pages across all items in
+// groups. It is the PageCount counterpart for pre-grouped result sets.
+func PageCountGrouped(groups []Group, limit int) int {
+	if limit <= 0 {
+		return 1
+	}
+
+	total := 0
+	for _, g := range groups {
+		total += len(g.Items)
+	}
+	return PageCount(total, limit)
+}
@@ -0,0 +1,146 @@
+package pagination
+
+import (
+	"reflect"
+	"testing"
+)
+
+func intGroup(key string, items ...any) Group {
+	return Group{Key: key, Items: items}
+}
+
+func TestPaginateGroups(t *testing.T) {
+	groups := []Group{
+		intGroup("2021", 1, 2, 3),
+		intGroup("2022", 4, 5),
+		intGroup("2023", 6, 7, 8, 9),
+	}
+
+	tests := []struct {
+		name         string
+		limit        int
+		offset       int
+		expectedPage []Group
+		expectedMeta map[string]PageMeta
+	}{
+		{
+			name:   "page spans a group boundary",
+			limit:  4,
+			offset: 2,
+			expectedPage: []Group{
+				intGroup("2021", 3),
+				intGroup("2022", 4, 5),
+				intGroup("2023", 6),
+			},
+			expectedMeta: map[string]PageMeta{
+				"first": {4, 0},
+				"next":  {4, 6},
+				"last":  {4, 8},
+			},
+		},
+		{
+			name:   "page fully within the last group",
+			limit:  2,
+			offset: 7,
+			expectedPage: []Group{
+				intGroup("2023", 8, 9),
+			},
+			expectedMeta: map[string]PageMeta{
+				"first": {2, 0},
+				"prev":  {2, 5},
+				"next":  {2, 9},
+				"last":  {2, 8},
+			},
+		},
+		{
+			name:         "offset at total returns an empty page",
+			limit:        4,
+			offset:       9,
+			expectedPage: []Group{},
+			expectedMeta: map[string]PageMeta{
+				"first": {4, 0},
+				"last":  {4, 8},
+			},
+		},
+		{
+			name:         "offset past total returns an empty page",
+			limit:        4,
+			offset:       100,
+			expectedPage: []Group{},
+			expectedMeta: map[string]PageMeta{
+				"first": {4, 0},
+				"last":  {4, 8},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			page, meta := PaginateGroups(groups, tc.limit, tc.offset)
+			if !reflect.DeepEqual(tc.expectedPage, page) {
+				t.Errorf("PaginateGroups() page:\n\texpected: %+v\n\tgot: %+v", tc.expectedPage, page)
+			}
+			if !reflect.DeepEqual(tc.expectedMeta, meta) {
+				t.Errorf("PaginateGroups() meta:\n\texpected: %+v\n\tgot: %+v", tc.expectedMeta, meta)
+			}
+		})
+	}
+}
+
+func TestPaginateGroupsEmptyGroups(t *testing.T) {
+	page, meta := PaginateGroups(nil, 10, 0)
+
+	expectedMeta := map[string]PageMeta{
+		"first": {10, 0},
+		"last":  {10, 0},
+	}
+	if !reflect.DeepEqual([]Group{}, page) {
+		t.Errorf("PaginateGroups() page:\n\texpected: %+v\n\tgot: %+v", []Group{}, page)
+	}
+	if !reflect.DeepEqual(expectedMeta, meta) {
+		t.Errorf("PaginateGroups() meta:\n\texpected: %+v\n\tgot: %+v", expectedMeta, meta)
+	}
+}
+
+func TestPaginateGroupsZeroLimit(t *testing.T) {
+	groups := []Group{
+		intGroup("2021", 1, 2, 3),
+	}
+
+	page, meta := PaginateGroups(groups, 0, 0)
+
+	expectedMeta := map[string]PageMeta{
+		"first": {0, 0},
+		"last":  {0, 0},
+	}
+	if !reflect.DeepEqual([]Group{}, page) {
+		t.Errorf("PaginateGroups() page:\n\texpected: %+v\n\tgot: %+v", []Group{}, page)
+	}
+	if !reflect.DeepEqual(expectedMeta, meta) {
+		t.Errorf("PaginateGroups() meta:\n\texpected: %+v\n\tgot: %+v", expectedMeta, meta)
+	}
+}
+
+func TestPageCountGroupedZeroLimit(t *testing.T) {
+	groups := []Group{
+		intGroup("2021", 1, 2, 3),
+	}
+
+	if got := PageCountGrouped(groups, 0); got != 1 {
+		t.Errorf("PageCountGrouped() = %d, want 1", got)
+	}
+}
+
+func TestPageCountGrouped(t *testing.T) {
+	groups := []Group{
+		intGroup("2021", 1, 2, 3),
+		intGroup("2022", 4, 5),
+	}
+
+	if got := PageCountGrouped(groups, 2); got != 3 {
+		t.Errorf("PageCountGrouped() = %d, want 3", got)
+	}
+	if got := PageCountGrouped(groups, 5); got != 1 {
+		t.Errorf("PageCountGrouped() = %d, want 1", got)
+	}
+}
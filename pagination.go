@@ -26,14 +26,91 @@ type PageLinks interface {
 	LastPageMeta() PageMeta
 	LastPageLink() (string, error)
 	ToHeader() (string, error)
+	PageWindow(radius int) ([]PageEntry, error)
 }
 
-// NewPageLinks creates a new pageLinks object.
-func NewPageLinks(baseUrl, rawQuery string, total, limit, offset int) (PageLinks, error) {
+// LinkStyle selects the external URL scheme used to represent a page.
+type LinkStyle int
+
+const (
+	// StyleOffset represents pages as ?limit=&offset= (the default).
+	StyleOffset LinkStyle = iota
+	// StylePage represents pages as ?page=&per_page=.
+	StylePage
+)
+
+// LinkOptions controls the query parameter names and URL scheme used when
+// rendering pagination links. The zero value is equivalent to DefaultLinkOptions().
+type LinkOptions struct {
+	Style        LinkStyle
+	PageParam    string
+	PerPageParam string
+	LimitParam   string
+	OffsetParam  string
+}
+
+// DefaultLinkOptions returns the LinkOptions used when none are supplied:
+// offset-style links with the "limit"/"offset" query parameters.
+func DefaultLinkOptions() LinkOptions {
+	return LinkOptions{
+		Style:        StyleOffset,
+		PageParam:    "page",
+		PerPageParam: "per_page",
+		LimitParam:   "limit",
+		OffsetParam:  "offset",
+	}
+}
+
+// withDefaults fills any unset parameter names with their defaults.
+func (o LinkOptions) withDefaults() LinkOptions {
+	d := DefaultLinkOptions()
+	if o.PageParam == "" {
+		o.PageParam = d.PageParam
+	}
+	if o.PerPageParam == "" {
+		o.PerPageParam = d.PerPageParam
+	}
+	if o.LimitParam == "" {
+		o.LimitParam = d.LimitParam
+	}
+	if o.OffsetParam == "" {
+		o.OffsetParam = d.OffsetParam
+	}
+	return o
+}
+
+func resolveLinkOptions(opts []LinkOptions) LinkOptions {
+	if len(opts) == 0 {
+		return DefaultLinkOptions()
+	}
+	return opts[0].withDefaults()
+}
+
+// NewPageLinks creates a new pageLinks object. An optional LinkOptions may be
+// passed to change the query parameter names or switch to page-number URLs;
+// it defaults to DefaultLinkOptions() when omitted.
+func NewPageLinks(baseUrl, rawQuery string, total, limit, offset int, opts ...LinkOptions) (PageLinks, error) {
+	return newPageLinks(baseUrl, rawQuery, total, limit, offset, false, resolveLinkOptions(opts))
+}
+
+// NewReversePageLinks creates a new pageLinks object walking backwards from
+// the last page, for "newest first" feeds such as activity logs.
+func NewReversePageLinks(baseUrl, rawQuery string, total, limit, offset int, opts ...LinkOptions) (PageLinks, error) {
+	return newPageLinks(baseUrl, rawQuery, total, limit, offset, true, resolveLinkOptions(opts))
+}
+
+func newPageLinks(baseUrl, rawQuery string, total, limit, offset int, reverse bool, opts LinkOptions) (PageLinks, error) {
+	if limit <= 0 {
+		return nil, errors.New("limit must be positive")
+	}
 	l := pageLinks{
 		baseUrl:  baseUrl,
-		pageMeta: BuildPageMeta(total, limit, offset),
+		pageMeta: buildPageMeta(total, limit, offset, reverse),
 		rawQuery: rawQuery,
+		opts:     opts,
+		total:    total,
+		limit:    limit,
+		offset:   offset,
 	}
 	return l, nil
 }
@@ -43,6 +120,10 @@ type pageLinks struct {
 	baseUrl  string
 	pageMeta map[string]PageMeta
 	rawQuery string
+	opts     LinkOptions
+	total    int
+	limit    int
+	offset   int
 }
 
 // ToHeader returns a header field representation of the links.
@@ -93,7 +174,7 @@ func (p pageLinks) FirstPageMeta() PageMeta {
 
 // FirstPageLink returns the link to the first page.
 func (p pageLinks) FirstPageLink() (string, error) {
-	return buildLink(p.baseUrl, p.rawQuery, p.FirstPageMeta().Offset)
+	return buildLink(p.baseUrl, p.rawQuery, p.FirstPageMeta(), p.opts)
 }
 
 // PrevPageMeta returns the metadata of the previous page.
@@ -112,7 +193,7 @@ func (p pageLinks) PrevPageLink() (string, error) {
 	if !p.HasPrevPage() {
 		return "", fmt.Errorf("Pagination has no previous page")
 	}
-	return buildLink(p.baseUrl, p.rawQuery, p.PrevPageMeta().Offset)
+	return buildLink(p.baseUrl, p.rawQuery, p.PrevPageMeta(), p.opts)
 }
 
 // NextPageMeta returns the metadata of the next page.
@@ -131,7 +212,7 @@ func (p pageLinks) NextPageLink() (string, error) {
 	if !p.HasNextPage() {
 		return "", fmt.Errorf("Pagination has no next page")
 	}
-	return buildLink(p.baseUrl, p.rawQuery, p.NextPageMeta().Offset)
+	return buildLink(p.baseUrl, p.rawQuery, p.NextPageMeta(), p.opts)
 }
 
 // LastPageMeta returns the metadata of the last page.
@@ -141,11 +222,23 @@ func (p pageLinks) LastPageMeta() PageMeta {
 
 // LastPageLink returns the link to the last page.
 func (p pageLinks) LastPageLink() (string, error) {
-	return buildLink(p.baseUrl, p.rawQuery, p.LastPageMeta().Offset)
+	return buildLink(p.baseUrl, p.rawQuery, p.LastPageMeta(), p.opts)
 }
 
 // BuildPageMeta build the metadata for the provided pagination coordinates.
 func BuildPageMeta(total int, limit int, offset int) map[string]PageMeta {
+	return buildPageMeta(total, limit, offset, false)
+}
+
+// BuildReversePageMeta builds the metadata for the provided pagination
+// coordinates in reverse mode: "first" still points at offset 0, but "next"
+// and "prev" are swapped relative to BuildPageMeta so that walking "next"
+// moves towards offset 0 instead of towards "last".
+func BuildReversePageMeta(total int, limit int, offset int) map[string]PageMeta {
+	return buildPageMeta(total, limit, offset, true)
+}
+
+func buildPageMeta(total int, limit int, offset int, reverse bool) map[string]PageMeta {
 	meta := map[string]PageMeta{
 		"first": {Limit: limit, Offset: 0},
 		"last":  {Limit: limit, Offset: getLastOffset(total, limit)},
@@ -156,6 +249,18 @@ func BuildPageMeta(total int, limit int, offset int) map[string]PageMeta {
 	if total >= (limit + offset) {
 		meta["next"] = PageMeta{Limit: limit, Offset: offset + limit}
 	}
+	if reverse {
+		next, hasNext := meta["next"]
+		prev, hasPrev := meta["prev"]
+		delete(meta, "next")
+		delete(meta, "prev")
+		if hasPrev {
+			meta["next"] = prev
+		}
+		if hasNext {
+			meta["prev"] = next
+		}
+	}
 	return meta
 }
 
@@ -169,6 +274,9 @@ func max(x int, y int) int {
 
 // getLastOffset returns the last offset for the total/limit values.
 func getLastOffset(total, limit int) int {
+	if total <= 0 {
+		return 0
+	}
 	offset := (total/limit - 1) * limit
 	if total%limit != 0 {
 		offset += limit
@@ -176,13 +284,21 @@ func getLastOffset(total, limit int) int {
 	return offset
 }
 
-// buildLink build the link for the provided values.
-func buildLink(baseUrl string, rawQuery string, offset int) (string, error) {
+// buildLink builds the link for the provided page, translating the internal
+// limit/offset coordinates into the external representation selected by opts.
+func buildLink(baseUrl string, rawQuery string, meta PageMeta, opts LinkOptions) (string, error) {
 	values, err := url.ParseQuery(rawQuery)
 	if err != nil {
 		return "", err
 	}
-	values.Set("offset", fmt.Sprintf("%d", offset))
+	switch opts.Style {
+	case StylePage:
+		values.Set(opts.PageParam, fmt.Sprintf("%d", meta.Offset/meta.Limit+1))
+		values.Set(opts.PerPageParam, fmt.Sprintf("%d", meta.Limit))
+	default:
+		values.Set(opts.LimitParam, fmt.Sprintf("%d", meta.Limit))
+		values.Set(opts.OffsetParam, fmt.Sprintf("%d", meta.Offset))
+	}
 	if baseUrl[len(baseUrl)-1:] != "?" {
 		baseUrl += "?"
 	}
@@ -199,9 +315,10 @@ type SortParserFunc func(sort string) (string, error)
 
 // Pagination contains pagination information.
 type Pagination struct {
-	Limit  int
-	Offset int
-	Sort   string
+	Limit   int
+	Offset  int
+	Sort    string
+	Reverse bool
 }
 
 // Validate validates to Pagination object.
@@ -216,11 +333,11 @@ func (p *Pagination) Validate() error {
 }
 
 func (p *Pagination) String() string {
-	return fmt.Sprintf("Limit: %d, Offset: %d, Sort: %s", p.Limit, p.Offset, p.Sort)
+	return fmt.Sprintf("Limit: %d, Offset: %d, Sort: %s, Reverse: %t", p.Limit, p.Offset, p.Sort, p.Reverse)
 }
 
 // NewPaginationFromArgs returns a new Pagination object based on the provided pagination arguments.
-func NewPaginationFromArgs(limit, offset *int, sort *string) (Pagination, error) {
+func NewPaginationFromArgs(limit, offset *int, sort *string, rev *bool) (Pagination, error) {
 	p := Pagination{}
 
 	if limit == nil {
@@ -240,6 +357,10 @@ func NewPaginationFromArgs(limit, offset *int, sort *string) (Pagination, error)
 		p.Sort = *sort
 	}
 
+	if rev != nil {
+		p.Reverse = *rev
+	}
+
 	return p, nil
 }
 
@@ -273,6 +394,11 @@ func (b *builder) WithSort(sort string) *builder {
 	return b
 }
 
+func (b *builder) WithReverse(reverse bool) *builder {
+	b.p.Reverse = reverse
+	return b
+}
+
 func (b *builder) Build() Pagination {
 	return b.p
 }
@@ -110,6 +110,88 @@ func TestBuildPageMeta(t *testing.T) {
 	}
 }
 
+func TestBuildPageMetaEdgeCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		limit    int
+		offset   int
+		expected map[string]PageMeta
+	}{
+		{
+			name:  "zero total",
+			total: 0, limit: 20, offset: 0,
+			expected: map[string]PageMeta{
+				"first": {20, 0},
+				"last":  {20, 0},
+			},
+		},
+		{
+			name:  "zero total with a non-zero offset",
+			total: 0, limit: 20, offset: 40,
+			expected: map[string]PageMeta{
+				"first": {20, 0},
+				"last":  {20, 0},
+			},
+		},
+		{
+			name:  "offset greater than total",
+			total: 10, limit: 20, offset: 40,
+			expected: map[string]PageMeta{
+				"first": {20, 0},
+				"last":  {20, 0},
+			},
+		},
+		{
+			name:  "limit greater than total",
+			total: 3, limit: 20, offset: 0,
+			expected: map[string]PageMeta{
+				"first": {20, 0},
+				"last":  {20, 0},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := BuildPageMeta(tc.total, tc.limit, tc.offset)
+			if !reflect.DeepEqual(tc.expected, meta) {
+				t.Errorf("BuildPageMeta(%d, %d, %d):\n\texpected: %+v\n\tgot: %+v",
+					tc.total, tc.limit, tc.offset, tc.expected, meta)
+			}
+		})
+	}
+}
+
+func TestToHeaderZeroTotal(t *testing.T) {
+	p, err := NewPageLinks("http://www.example.com/abc", "", 0, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, err := p.ToHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedHeader := `<http://www.example.com/abc?limit=10&offset=0>; rel="first", ` +
+		`<http://www.example.com/abc?limit=10&offset=0>; rel="last"`
+	if header != expectedHeader {
+		t.Fatalf("ToHeader()\n\texpected: %s\n\tgot: %s", expectedHeader, header)
+	}
+	if p.HasNextPage() || p.HasPrevPage() {
+		t.Fatal("expected no next/prev page for a zero-total result set")
+	}
+}
+
+func TestNewPageLinksInvalidLimit(t *testing.T) {
+	if _, err := NewPageLinks("http://www.example.com/abc", "", 10, 0, 0); err == nil {
+		t.Fatal("expected an error for a non-positive limit")
+	}
+	if _, err := NewPageLinks("http://www.example.com/abc", "", 10, -1, 0); err == nil {
+		t.Fatal("expected an error for a negative limit")
+	}
+}
+
 func TestGetLastOffset(t *testing.T) {
 	tests := []struct {
 		total    int
@@ -121,6 +203,7 @@ func TestGetLastOffset(t *testing.T) {
 		{total: 150, limit: 7, expected: 147},
 		{total: 199, limit: 9, expected: 198},
 		{total: 100, limit: 15, expected: 90},
+		{total: 0, limit: 20, expected: 0},
 	}
 
 	for _, tc := range tests {
@@ -212,6 +295,141 @@ func TestToHeader(t *testing.T) {
 	}
 }
 
+func TestBuildReversePageMeta(t *testing.T) {
+	tests := []struct {
+		total    int
+		limit    int
+		offset   int
+		expected map[string]PageMeta
+	}{
+		{
+			total: 100, limit: 20, offset: 0,
+			expected: map[string]PageMeta{
+				"first": {20, 0},
+				"prev":  {20, 20},
+				"last":  {20, 80},
+			},
+		},
+		{
+			total: 100, limit: 20, offset: 60,
+			expected: map[string]PageMeta{
+				"first": {20, 0},
+				"prev":  {20, 80},
+				"next":  {20, 40},
+				"last":  {20, 80},
+			},
+		},
+		{
+			total: 200, limit: 20, offset: 20,
+			expected: map[string]PageMeta{
+				"first": {20, 0},
+				"next":  {20, 0},
+				"prev":  {20, 40},
+				"last":  {20, 180},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		meta := BuildReversePageMeta(tc.total, tc.limit, tc.offset)
+		if !reflect.DeepEqual(tc.expected, meta) {
+			t.Errorf("BuildReversePageMeta(%d, %d, %d):\n\texpected: %+v\n\tgot: %+v",
+				tc.total, tc.limit, tc.offset, tc.expected, meta)
+		}
+	}
+}
+
+func TestNewReversePageLinks(t *testing.T) {
+	baseUrl := "http://www.example.com/abc"
+	rawQuery := "offset=60&limit=20"
+	total := 100
+	limit := 20
+	offset := 60
+
+	p, err := NewReversePageLinks(baseUrl, rawQuery, total, limit, offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !p.HasNextPage() {
+		t.Fatal("expected HasNextPage() to be true")
+	}
+	nextPageLink, err := p.NextPageLink()
+	if err != nil {
+		t.Fatalf("NextPageLink() error: %+v", err)
+	}
+	expectedNextPageLink := "http://www.example.com/abc?limit=20&offset=40"
+	if nextPageLink != expectedNextPageLink {
+		t.Fatalf("NextPageLink()\n\texpected: %s\n\tgot: %s", expectedNextPageLink, nextPageLink)
+	}
+
+	if !p.HasPrevPage() {
+		t.Fatal("expected HasPrevPage() to be true")
+	}
+	prevPageLink, err := p.PrevPageLink()
+	if err != nil {
+		t.Fatalf("PrevPageLink() error: %+v", err)
+	}
+	expectedPrevPageLink := "http://www.example.com/abc?limit=20&offset=80"
+	if prevPageLink != expectedPrevPageLink {
+		t.Fatalf("PrevPageLink()\n\texpected: %s\n\tgot: %s", expectedPrevPageLink, prevPageLink)
+	}
+}
+
+func TestNewPageLinksStylePage(t *testing.T) {
+	baseUrl := "https://api.example.com/api/v1/cars"
+	rawQuery := "page=4&per_page=15"
+	total := 100
+	limit := 15
+	offset := 45
+
+	p, err := NewPageLinks(baseUrl, rawQuery, total, limit, offset, LinkOptions{Style: StylePage})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nextPageLink, err := p.NextPageLink()
+	if err != nil {
+		t.Fatalf("NextPageLink() error: %+v", err)
+	}
+	expectedNextPageLink := "https://api.example.com/api/v1/cars?page=5&per_page=15"
+	if nextPageLink != expectedNextPageLink {
+		t.Fatalf("NextPageLink()\n\texpected: %s\n\tgot: %s", expectedNextPageLink, nextPageLink)
+	}
+
+	firstPageLink, err := p.FirstPageLink()
+	if err != nil {
+		t.Fatalf("FirstPageLink() error: %+v", err)
+	}
+	expectedFirstPageLink := "https://api.example.com/api/v1/cars?page=1&per_page=15"
+	if firstPageLink != expectedFirstPageLink {
+		t.Fatalf("FirstPageLink()\n\texpected: %s\n\tgot: %s", expectedFirstPageLink, firstPageLink)
+	}
+}
+
+func TestNewPageLinksCustomParamNames(t *testing.T) {
+	baseUrl := "https://api.example.com/api/v1/cars"
+	rawQuery := "l=15&o=60"
+	total := 100
+	limit := 15
+	offset := 60
+
+	opts := LinkOptions{OffsetParam: "o", LimitParam: "l"}
+	p, err := NewPageLinks(baseUrl, rawQuery, total, limit, offset, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nextPageLink, err := p.NextPageLink()
+	if err != nil {
+		t.Fatalf("NextPageLink() error: %+v", err)
+	}
+	expectedNextPageLink := "https://api.example.com/api/v1/cars?l=15&o=75"
+	if nextPageLink != expectedNextPageLink {
+		t.Fatalf("NextPageLink()\n\texpected: %s\n\tgot: %s", expectedNextPageLink, nextPageLink)
+	}
+}
+
 func TestPageCount(t *testing.T) {
 	tests := []struct {
 		count             int
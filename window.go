@@ -0,0 +1,83 @@
+package pagination
+
+import "fmt"
+
+// PageEntry is a single entry in a page-number UI navigator, either a
+// selectable page or a gap sentinel (e.g. "…") where IsGap is true.
+type PageEntry struct {
+	Number    int
+	Offset    int
+	IsCurrent bool
+	IsGap     bool
+	Link      string
+}
+
+// PageWindow returns the sequence of page numbers to render in a UI
+// navigator, e.g. "1 … 4 5 [6] 7 8 … 20", always including the first and
+// last page plus radius pages on either side of the current page. Gaps
+// between included pages are represented by a PageEntry with IsGap set.
+func PageWindow(total, limit, offset, radius int) []PageEntry {
+	if limit <= 0 {
+		return nil
+	}
+
+	pageCount := PageCount(total, limit)
+	current := offset/limit + 1
+	if current < 1 {
+		current = 1
+	}
+	if current > pageCount {
+		current = pageCount
+	}
+
+	low := current - radius
+	if low < 1 {
+		low = 1
+	}
+	high := current + radius
+	if high > pageCount {
+		high = pageCount
+	}
+
+	pages := []int{1}
+	for n := low; n <= high; n++ {
+		if n != 1 && n != pageCount {
+			pages = append(pages, n)
+		}
+	}
+	if pageCount > 1 {
+		pages = append(pages, pageCount)
+	}
+
+	entries := make([]PageEntry, 0, len(pages)+2)
+	prev := 0
+	for _, n := range pages {
+		if prev != 0 && n-prev > 1 {
+			entries = append(entries, PageEntry{IsGap: true})
+		}
+		entries = append(entries, PageEntry{
+			Number:    n,
+			Offset:    (n - 1) * limit,
+			IsCurrent: n == current,
+		})
+		prev = n
+	}
+	return entries
+}
+
+// PageWindow returns PageWindow(total, limit, offset, radius) for this
+// PageLinks, with each entry's Link populated using the configured LinkOptions.
+func (p pageLinks) PageWindow(radius int) ([]PageEntry, error) {
+	entries := PageWindow(p.total, p.limit, p.offset, radius)
+	for i, e := range entries {
+		if e.IsGap {
+			continue
+		}
+		link, err := buildLink(p.baseUrl, p.rawQuery, PageMeta{Limit: p.limit, Offset: e.Offset}, p.opts)
+		if err != nil {
+			return nil, fmt.Errorf("build page %d link: %w", e.Number, err)
+		}
+		entries[i].Link = link
+	}
+	return entries, nil
+}
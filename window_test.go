@@ -0,0 +1,92 @@
+package pagination
+
+import (
+	"reflect"
+	"testing"
+)
+
+func numbers(entries []PageEntry) []int {
+	out := make([]int, len(entries))
+	for i, e := range entries {
+		if e.IsGap {
+			out[i] = -1
+		} else {
+			out[i] = e.Number
+		}
+	}
+	return out
+}
+
+func TestPageWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		limit    int
+		offset   int
+		radius   int
+		expected []int
+	}{
+		{
+			name:  "current page in the middle with gaps on both sides",
+			total: 200, limit: 10, offset: 50, radius: 2,
+			expected: []int{1, -1, 4, 5, 6, 7, 8, -1, 20},
+		},
+		{
+			name:  "current page near the start, no leading gap",
+			total: 200, limit: 10, offset: 0, radius: 2,
+			expected: []int{1, 2, 3, -1, 20},
+		},
+		{
+			name:  "current page near the end, no trailing gap",
+			total: 200, limit: 10, offset: 190, radius: 2,
+			expected: []int{1, -1, 18, 19, 20},
+		},
+		{
+			name:  "fewer pages than the window",
+			total: 30, limit: 10, offset: 10, radius: 2,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:  "single page",
+			total: 5, limit: 10, offset: 0, radius: 2,
+			expected: []int{1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entries := PageWindow(tc.total, tc.limit, tc.offset, tc.radius)
+			got := numbers(entries)
+			if !reflect.DeepEqual(tc.expected, got) {
+				t.Errorf("PageWindow(%d, %d, %d, %d):\n\texpected: %+v\n\tgot: %+v",
+					tc.total, tc.limit, tc.offset, tc.radius, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPageLinksPageWindow(t *testing.T) {
+	p, err := NewPageLinks("https://api.example.com/cars", "limit=10&offset=50", 200, 10, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := p.PageWindow(2)
+	if err != nil {
+		t.Fatalf("PageWindow() error: %+v", err)
+	}
+
+	current := entries[4]
+	if !current.IsCurrent || current.Number != 6 {
+		t.Fatalf("expected entry 4 to be the current page (6), got %+v", current)
+	}
+	expectedLink := "https://api.example.com/cars?limit=10&offset=50"
+	if current.Link != expectedLink {
+		t.Fatalf("current page Link\n\texpected: %s\n\tgot: %s", expectedLink, current.Link)
+	}
+
+	gap := entries[1]
+	if !gap.IsGap || gap.Link != "" {
+		t.Fatalf("expected entry 1 to be an empty gap, got %+v", gap)
+	}
+}